@@ -8,7 +8,7 @@ export async function GET(request: NextRequest) {
       headers: {
         'Content-Type': 'application/json',
       },
-      cache: 'force-cache',
+      cache: 'no-store',
     });
 
     if (!response.ok) {